@@ -1,5 +1,11 @@
 // hello-go - WASI HTTP component in Go using TinyGo
-// NOTE: Go components may hang on mik runtime. Use tests/fixtures/multilang/go/hello-go.wasm
+//
+// TinyGo's cooperative scheduler can hang on the mik runtime if the
+// component is instantiated cold. The supported workaround is to run it
+// through pkg/mikruntime: pre-instantiate via a warm mikruntime.Pool so
+// scheduler init happens once up front, and/or compose the module with a
+// polling-loop shim via mikruntime.Compose. See pkg/mikruntime for the
+// RuntimeConfig knobs (GoWarmPoolSize, ComposeWith).
 package main
 
 import (
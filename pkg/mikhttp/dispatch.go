@@ -0,0 +1,24 @@
+package mikhttp
+
+import (
+	"net/http"
+
+	"github.com/rajatjindal/wasi-go-sdk/pkg/wasihttp"
+)
+
+// HandleStreaming registers handler as the component's wasi:http
+// entrypoint. It's currently a thin alias for wasihttp.Handle: the SDK's
+// own ResponseWriter already flushes each Write straight to the
+// outgoing-body stream, and Request.Body is already backed by the
+// incoming-body's input-stream, so there's no separate buffering path
+// left for HandleStreaming to avoid — wasihttp.Handle only exposes
+// net/http types, not the underlying wasi:http resources, so mikhttp has
+// no lower level left to build its own streaming writer on top of.
+//
+// The wrapper still earns its keep: call sites depend on mikhttp's own
+// naming instead of reaching into the SDK package directly, so a future
+// SDK version that needs extra wiring here (e.g. exposing trailers)
+// doesn't require every fixture to change.
+func HandleStreaming(handler http.Handler) {
+	wasihttp.Handle(handler.ServeHTTP)
+}
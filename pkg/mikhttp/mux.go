@@ -0,0 +1,67 @@
+package mikhttp
+
+import (
+	"net/http"
+)
+
+// ServeMux is a wasi:http-aware router built on top of http.ServeMux. It
+// lets a single guest component register multiple method+path handlers
+// at init() time and expose a real REST surface, instead of the
+// one-endpoint-per-wasm convention wasihttp.Handle forces today.
+//
+// Register routes during init(), then pass the mux itself to
+// wasihttp.Handle so the mik host still has a single exported entrypoint
+// to call into:
+//
+//	var mux = mikhttp.NewServeMux()
+//
+//	func init() {
+//		mux.HandleFunc("GET /users/{id}", getUser)
+//		mux.HandleFunc("POST /users", createUser)
+//		wasihttp.Handle(mux.ServeHTTP)
+//	}
+type ServeMux struct {
+	mux         *http.ServeMux
+	middlewares []func(http.Handler) http.Handler
+}
+
+// NewServeMux returns an empty ServeMux ready for route registration.
+func NewServeMux() *ServeMux {
+	return &ServeMux{mux: http.NewServeMux()}
+}
+
+// Handle registers handler for the given pattern. pattern follows the
+// same "METHOD /path/{param}" syntax as http.ServeMux (Go 1.22+), so
+// method and path parameters are handled by the standard library matcher
+// rather than a bespoke one.
+func (m *ServeMux) Handle(pattern string, handler http.Handler) {
+	m.mux.Handle(pattern, handler)
+}
+
+// HandleFunc registers handler as the handler for pattern.
+func (m *ServeMux) HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request)) {
+	m.mux.HandleFunc(pattern, handler)
+}
+
+// Use appends middleware to the chain applied to every request before it
+// reaches the matched handler, in registration order.
+func (m *ServeMux) Use(middleware func(http.Handler) http.Handler) {
+	m.middlewares = append(m.middlewares, middleware)
+}
+
+// ServeHTTP matches r against the registered routes using the request's
+// method and path, running it through the middleware chain before
+// dispatch. This method is what gets passed to wasihttp.Handle.
+func (m *ServeMux) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var h http.Handler = m.mux
+	for i := len(m.middlewares) - 1; i >= 0; i-- {
+		h = m.middlewares[i](h)
+	}
+	h.ServeHTTP(w, r)
+}
+
+// PathValue returns the value of the named path parameter for r, as
+// populated by the matching "{name}" segment in a registered pattern.
+func PathValue(r *http.Request, name string) string {
+	return r.PathValue(name)
+}
@@ -0,0 +1,62 @@
+// Package mikhttp provides ergonomic net/http adapters on top of
+// wasi-go-sdk's pkg/wasihttp, so guest code can use the standard
+// http.Client/http.Handler surface instead of calling wasihttp directly.
+//
+// Everything here is plain Go with no TinyGo-specific dependencies, so
+// the same handler built with TinyGo or with upstream Go + wit-bindgen-go
+// (see tests/fixtures/multilang/go/Makefile) presents an identical
+// http.Handler surface to guest code.
+package mikhttp
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/rajatjindal/wasi-go-sdk/pkg/wasihttp"
+)
+
+// NewRoundTripper returns an http.RoundTripper that dispatches outbound
+// requests through the wasi:http outgoing-handler import via
+// wasihttp.Transport, turning the mik host into the actual network
+// transport for the guest component. Install it as the transport for any
+// *http.Client, or set it as http.DefaultTransport so existing callers
+// that use http.Get/http.Post pick it up for free:
+//
+//	http.DefaultClient.Transport = mikhttp.NewRoundTripper()
+//
+// Per the http.RoundTripper contract, req.Body is always closed, on both
+// the success and error paths. wasihttp.Transport doesn't do this
+// itself, so RoundTripper wraps it rather than handing it out directly.
+//
+// wasihttp.Transport has two gaps RoundTripper works around rather than
+// passing through: it builds the outgoing authority from req.Host, which
+// is empty on every request built by http.Get/http.NewRequest (those put
+// the target host in req.URL.Host instead); and its Send never writes a
+// request body to the wire at all, silently dropping one instead of
+// erroring. RoundTripper fills in the authority and rejects non-empty
+// bodies outright, so a guest finds out its POST didn't go through
+// instead of the server silently receiving an empty one.
+func NewRoundTripper() http.RoundTripper {
+	return roundTripper{inner: wasihttp.NewTransport()}
+}
+
+type roundTripper struct {
+	inner http.RoundTripper
+}
+
+func (t roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		defer req.Body.Close()
+	}
+	if req.Body != nil && req.Body != http.NoBody {
+		return nil, fmt.Errorf("mikhttp: request bodies are not supported by wasihttp.Transport")
+	}
+
+	if req.Host == "" {
+		outReq := req.Clone(req.Context())
+		outReq.Host = req.URL.Host
+		req = outReq
+	}
+
+	return t.inner.RoundTrip(req)
+}
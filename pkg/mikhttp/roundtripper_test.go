@@ -0,0 +1,94 @@
+package mikhttp
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestRoundTripperAcceptsNoBodyRequest(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/", nil)
+
+	rt := roundTripper{inner: fakeInner{resp: &http.Response{StatusCode: http.StatusOK}}}
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+}
+
+func TestRoundTripperRejectsNonEmptyBodyButStillCloses(t *testing.T) {
+	body := &closeTrackingBody{Reader: strings.NewReader("payload")}
+	req := httptest.NewRequest("POST", "http://example.com/", body)
+
+	rt := roundTripper{inner: fakeInner{resp: &http.Response{StatusCode: http.StatusOK}}}
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Fatal("RoundTrip: want error for a non-empty body, got nil")
+	}
+
+	if !body.closed {
+		t.Error("req.Body not closed")
+	}
+}
+
+func TestRoundTripperReturnsInnerError(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/", nil)
+
+	rt := roundTripper{inner: fakeInner{err: errors.New("boom")}}
+	if _, err := rt.RoundTrip(req); err == nil {
+		t.Fatal("RoundTrip: want error, got nil")
+	}
+}
+
+func TestRoundTripperFillsAuthorityFromURLHost(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/path", nil)
+	req.Host = ""
+
+	var gotHost string
+	rt := roundTripper{inner: fakeInner{resp: &http.Response{StatusCode: http.StatusOK}, capture: &gotHost}}
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+
+	if gotHost != "example.com" {
+		t.Errorf("inner saw req.Host = %q, want %q", gotHost, "example.com")
+	}
+	if req.Host != "" {
+		t.Errorf("caller's req.Host mutated to %q, want unchanged empty string", req.Host)
+	}
+}
+
+func TestRoundTripperLeavesExplicitHostAlone(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://example.com/path", nil)
+	req.Host = "explicit.example"
+
+	var gotHost string
+	rt := roundTripper{inner: fakeInner{resp: &http.Response{StatusCode: http.StatusOK}, capture: &gotHost}}
+	if _, err := rt.RoundTrip(req); err != nil {
+		t.Fatalf("RoundTrip: %v", err)
+	}
+
+	if gotHost != "explicit.example" {
+		t.Errorf("inner saw req.Host = %q, want %q", gotHost, "explicit.example")
+	}
+}
+
+type fakeInner struct {
+	resp    *http.Response
+	err     error
+	capture *string
+}
+
+func (f fakeInner) RoundTrip(req *http.Request) (*http.Response, error) {
+	if f.capture != nil {
+		*f.capture = req.Host
+	}
+	return f.resp, f.err
+}
+
+type closeTrackingBody struct {
+	*strings.Reader
+	closed bool
+}
+
+func (b *closeTrackingBody) Close() error { b.closed = true; return nil }
@@ -0,0 +1,21 @@
+package mikhttp
+
+// TrailerSetter is implemented by ResponseWriters that can emit HTTP
+// trailers once a streamed response body has finished. Handlers that
+// need trailers (e.g. gRPC-style status, or a checksum computed while
+// streaming) should type-assert for it rather than relying on the
+// net/http "announce trailers via header" convention, since wasi:http
+// trailers are a distinct resource from headers and wasihttp's own
+// ResponseWriter doesn't expose a way to set them:
+//
+//	if tw, ok := w.(mikhttp.TrailerSetter); ok {
+//		tw.SetTrailer("X-Stream-Complete", "true")
+//	}
+//
+// Handlers should guard every call behind the ok check above: outside of
+// tests (wadgetest's recorder implements TrailerSetter), the wasi:http
+// ResponseWriter the SDK hands HandleStreaming today does not, so the
+// trailer is silently skipped rather than sent.
+type TrailerSetter interface {
+	SetTrailer(key, value string)
+}
@@ -0,0 +1,173 @@
+// Package mikruntime implements the warm-pool and composition subsystem
+// that lets the mik host amortize TinyGo's cooperative-scheduler init
+// cost across requests, and compose a Go guest with a shim component
+// that supplies a scheduler-compatible polling loop.
+//
+// The host owns actual wasm instantiation (loading the module, wiring
+// imports, running it on wasmtime or similar); this package only owns
+// pool lifecycle and composition bookkeeping, so it plugs into whatever
+// instantiation strategy the host uses via the Instantiator interface.
+//
+// Compose and NewPool are deliberately two separate steps rather than
+// NewPool calling Compose itself: composition picks which component
+// bytes get loaded, while GoWarmPoolSize only controls how many
+// instances of those bytes are kept warm. A typical caller wires them
+// together explicitly:
+//
+//	composedPath, err := mikruntime.Compose(ctx, cfg, "hello-go.wasm", "hello-go.composed.wasm")
+//	if err != nil {
+//		return err
+//	}
+//	pool, err := mikruntime.NewPool(ctx, cfg, wasmtimeInstantiator(composedPath))
+//	if err != nil {
+//		return err
+//	}
+//	defer pool.Close()
+//
+// where wasmtimeInstantiator is the host's own Instantiator
+// implementation, built to load whatever component is at composedPath.
+package mikruntime
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"sync"
+)
+
+// RuntimeConfig captures the per-component scheduling hints the mik host
+// reads when loading a Go/TinyGo guest.
+type RuntimeConfig struct {
+	// GoWarmPoolSize is the number of pre-instantiated copies of this
+	// component Pool keeps ready, amortizing TinyGo's cooperative
+	// scheduler init cost across requests. Zero disables the warm pool
+	// (every Acquire instantiates fresh, the previous always-cold-start
+	// behavior).
+	GoWarmPoolSize int
+
+	// ComposeWith lists component paths to link against this one (via
+	// `wasm-tools compose`) before instantiation — for example a shim
+	// supplying a synchronous polling loop compatible with TinyGo's
+	// scheduler, which is the supported workaround for the
+	// Go-components-may-hang issue noted in examples/hello-go.
+	ComposeWith []string
+}
+
+// DefaultRuntimeConfig returns the zero-value behavior mik used before
+// this package existed: no warm pool, no composition.
+func DefaultRuntimeConfig() RuntimeConfig {
+	return RuntimeConfig{}
+}
+
+// Instance is a single instantiated guest component, as handed out by an
+// Instantiator. Closing it releases whatever host-side resources back the
+// instance (e.g. a wasmtime Store).
+type Instance interface {
+	Close() error
+}
+
+// Instantiator creates a new Instance of a guest component. The mik host
+// supplies the concrete implementation; Pool only sequences calls to it.
+type Instantiator interface {
+	Instantiate(ctx context.Context) (Instance, error)
+}
+
+// Pool pre-instantiates cfg.GoWarmPoolSize instances at construction time
+// and hands them out via Acquire/Release, so callers pay TinyGo's
+// scheduler init cost once per pool fill rather than once per request.
+type Pool struct {
+	cfg    RuntimeConfig
+	new    Instantiator
+	mu     sync.Mutex
+	idle   []Instance
+	closed bool
+}
+
+// NewPool builds a Pool and eagerly fills it with cfg.GoWarmPoolSize
+// instances from inst. If any pre-instantiation fails, already-created
+// instances are closed and the error is returned.
+func NewPool(ctx context.Context, cfg RuntimeConfig, inst Instantiator) (*Pool, error) {
+	p := &Pool{cfg: cfg, new: inst}
+
+	for i := 0; i < cfg.GoWarmPoolSize; i++ {
+		instance, err := inst.Instantiate(ctx)
+		if err != nil {
+			p.Close()
+			return nil, fmt.Errorf("mikruntime: warming pool (instance %d/%d): %w", i+1, cfg.GoWarmPoolSize, err)
+		}
+		p.idle = append(p.idle, instance)
+	}
+
+	return p, nil
+}
+
+// Acquire returns a warm instance if one is idle, instantiating a fresh
+// one on demand otherwise (e.g. the pool is empty, or GoWarmPoolSize is
+// 0). Callers must Release the instance when done.
+func (p *Pool) Acquire(ctx context.Context) (Instance, error) {
+	p.mu.Lock()
+	if n := len(p.idle); n > 0 {
+		instance := p.idle[n-1]
+		p.idle = p.idle[:n-1]
+		p.mu.Unlock()
+		return instance, nil
+	}
+	p.mu.Unlock()
+
+	return p.new.Instantiate(ctx)
+}
+
+// Release returns instance to the idle pool, up to GoWarmPoolSize
+// instances. Beyond that (or after Close), the instance is closed instead
+// of retained.
+func (p *Pool) Release(instance Instance) error {
+	p.mu.Lock()
+	if !p.closed && len(p.idle) < p.cfg.GoWarmPoolSize {
+		p.idle = append(p.idle, instance)
+		p.mu.Unlock()
+		return nil
+	}
+	p.mu.Unlock()
+
+	return instance.Close()
+}
+
+// Close closes every idle instance still held by the pool. Instances
+// currently Acquired and not yet Released are the caller's responsibility.
+func (p *Pool) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.closed = true
+	var firstErr error
+	for _, instance := range p.idle {
+		if err := instance.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	p.idle = nil
+	return firstErr
+}
+
+// Compose links componentPath against cfg.ComposeWith (if any) using
+// `wasm-tools compose`, writing the composed component to outPath and
+// returning outPath. If cfg.ComposeWith is empty, componentPath is
+// returned unchanged and no subprocess is run.
+func Compose(ctx context.Context, cfg RuntimeConfig, componentPath, outPath string) (string, error) {
+	if len(cfg.ComposeWith) == 0 {
+		return componentPath, nil
+	}
+
+	args := []string{"compose", componentPath}
+	for _, dep := range cfg.ComposeWith {
+		args = append(args, "-d", dep)
+	}
+	args = append(args, "-o", outPath)
+
+	cmd := exec.CommandContext(ctx, "wasm-tools", args...)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("mikruntime: wasm-tools compose: %w: %s", err, out)
+	}
+
+	return outPath, nil
+}
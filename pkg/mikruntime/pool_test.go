@@ -0,0 +1,104 @@
+package mikruntime
+
+import (
+	"context"
+	"testing"
+)
+
+type fakeInstance struct {
+	closed bool
+}
+
+func (f *fakeInstance) Close() error {
+	f.closed = true
+	return nil
+}
+
+type fakeInstantiator struct {
+	count int
+}
+
+func (f *fakeInstantiator) Instantiate(ctx context.Context) (Instance, error) {
+	f.count++
+	return &fakeInstance{}, nil
+}
+
+func TestNewPoolFillsWarmPool(t *testing.T) {
+	inst := &fakeInstantiator{}
+	pool, err := NewPool(context.Background(), RuntimeConfig{GoWarmPoolSize: 3}, inst)
+	if err != nil {
+		t.Fatalf("NewPool: %v", err)
+	}
+	if inst.count != 3 {
+		t.Fatalf("Instantiate called %d times, want 3", inst.count)
+	}
+	if len(pool.idle) != 3 {
+		t.Fatalf("idle = %d, want 3", len(pool.idle))
+	}
+}
+
+func TestAcquireReusesWarmInstance(t *testing.T) {
+	inst := &fakeInstantiator{}
+	pool, err := NewPool(context.Background(), RuntimeConfig{GoWarmPoolSize: 1}, inst)
+	if err != nil {
+		t.Fatalf("NewPool: %v", err)
+	}
+
+	instance, err := pool.Acquire(context.Background())
+	if err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	if inst.count != 1 {
+		t.Fatalf("Instantiate called %d times after Acquire, want 1 (should reuse the warm instance)", inst.count)
+	}
+
+	if err := pool.Release(instance); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if len(pool.idle) != 1 {
+		t.Fatalf("idle after Release = %d, want 1", len(pool.idle))
+	}
+}
+
+func TestAcquireInstantiatesWhenPoolEmpty(t *testing.T) {
+	inst := &fakeInstantiator{}
+	pool, err := NewPool(context.Background(), RuntimeConfig{GoWarmPoolSize: 0}, inst)
+	if err != nil {
+		t.Fatalf("NewPool: %v", err)
+	}
+
+	if _, err := pool.Acquire(context.Background()); err != nil {
+		t.Fatalf("Acquire: %v", err)
+	}
+	if inst.count != 1 {
+		t.Fatalf("Instantiate called %d times, want 1", inst.count)
+	}
+}
+
+func TestCloseClosesIdleInstances(t *testing.T) {
+	inst := &fakeInstantiator{}
+	pool, err := NewPool(context.Background(), RuntimeConfig{GoWarmPoolSize: 2}, inst)
+	if err != nil {
+		t.Fatalf("NewPool: %v", err)
+	}
+
+	idle := append([]Instance{}, pool.idle...)
+	if err := pool.Close(); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	for _, instance := range idle {
+		if !instance.(*fakeInstance).closed {
+			t.Error("idle instance not closed")
+		}
+	}
+}
+
+func TestComposeWithoutDepsReturnsInputUnchanged(t *testing.T) {
+	out, err := Compose(context.Background(), RuntimeConfig{}, "hello-go.wasm", "out.wasm")
+	if err != nil {
+		t.Fatalf("Compose: %v", err)
+	}
+	if out != "hello-go.wasm" {
+		t.Fatalf("out = %q, want %q", out, "hello-go.wasm")
+	}
+}
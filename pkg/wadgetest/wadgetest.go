@@ -0,0 +1,87 @@
+// Package wadgetest lets a handler registered via wasihttp.Handle (or
+// mikhttp.ServeMux/mikhttp.HandleStreaming) be exercised from a plain
+// `go test` run, without compiling to wasm or spinning up the mik
+// runtime. Handler code in this repo is already plain net/http, so the
+// bridge itself is a thin wrapper over net/http/httptest rather than a
+// wasi:http resource shim — no wasm-side types are involved.
+//
+// This plays the same role the wadge bridging framework plays for other
+// guest languages: run the guest's own exported entrypoint natively
+// instead of through a wasm build.
+//
+// Scope: Do drives handlers against httptest.ResponseRecorder, not the
+// SDK's wasi:http ResponseWriter that HandleStreaming passes through to
+// (see pkg/mikhttp/dispatch.go). It's the right tool for asserting on a
+// handler's own request/response logic — status, headers, body,
+// trailers set via mikhttp.TrailerSetter — but it does not exercise
+// commit timing, chunking, or anything else specific to the wasi:http
+// wire path, and no test in this repo covers that path.
+package wadgetest
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+)
+
+// Handler is the shape of the function guests register via
+// wasihttp.Handle.
+type Handler func(http.ResponseWriter, *http.Request)
+
+// recorder extends httptest.ResponseRecorder with mikhttp.TrailerSetter
+// (see pkg/mikhttp/stream.go), so handlers that set trailers through it
+// get trailer coverage under test instead of silently no-oping the
+// w.(mikhttp.TrailerSetter) assertion.
+type recorder struct {
+	*httptest.ResponseRecorder
+	trailer http.Header
+}
+
+func newRecorder() *recorder {
+	return &recorder{
+		ResponseRecorder: httptest.NewRecorder(),
+		trailer:          make(http.Header),
+	}
+}
+
+// SetTrailer implements mikhttp.TrailerSetter.
+func (r *recorder) SetTrailer(key, value string) {
+	r.trailer.Add(key, value)
+}
+
+// Do invokes handler in-process with req and returns the aggregated
+// *http.Response, the same way the mik host would after routing an
+// inbound wasi:http request to the guest's exported handler. Trailers set
+// via mikhttp.TrailerSetter are attached to the result's Trailer field.
+//
+//	resp, err := wadgetest.Do(myHandler, wadgetest.NewRequest("GET", "/", nil))
+func Do(handler Handler, req *http.Request) (*http.Response, error) {
+	rec := newRecorder()
+	handler(rec, req)
+	resp := rec.Result()
+	resp.Trailer = rec.trailer
+	return resp, nil
+}
+
+// DoBody is a convenience wrapper around Do for handlers that don't need
+// to inspect response headers/status, returning just the response body.
+func DoBody(handler Handler, req *http.Request) ([]byte, error) {
+	resp, err := Do(handler, req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return io.ReadAll(resp.Body)
+}
+
+// NewRequest builds an *http.Request the way an inbound wasi:http
+// incoming-request would be translated by the SDK, so tests don't need
+// to reach for httptest.NewRequest directly.
+func NewRequest(method, target string, body []byte) *http.Request {
+	var r io.Reader
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+	return httptest.NewRequest(method, target, r)
+}
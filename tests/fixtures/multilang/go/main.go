@@ -1,17 +1,94 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 
-	"github.com/rajatjindal/wasi-go-sdk/pkg/wasihttp"
+	"github.com/dufeut/mik/pkg/mikhttp"
 )
 
+// upstream is a demo URL proxied via the wasi:http outgoing-handler. It
+// lets the fixture exercise both inbound and outbound wasi:http paths in
+// one component.
+const upstream = "https://httpbin.org/get"
+
+// mux registers every route this component exposes. It's a package
+// variable (rather than local to init) so main_test.go can drive
+// individual routes natively via wadgetest.Do, without a wasm build.
+var mux = mikhttp.NewServeMux()
+
 func init() {
-	wasihttp.Handle(func(w http.ResponseWriter, r *http.Request) {
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte(`{"message":"Hello from Go!","lang":"go"}`))
+	http.DefaultClient.Transport = mikhttp.NewRoundTripper()
+
+	mux.HandleFunc("GET /", hello)
+	mux.HandleFunc("GET /proxy", func(w http.ResponseWriter, r *http.Request) { proxyUpstream(w) })
+	mux.HandleFunc("GET /stream", func(w http.ResponseWriter, r *http.Request) { streamCounter(w) })
+
+	// HandleStreaming currently just forwards to wasihttp.Handle, whose
+	// request body and response writer are already backed by wasi:http
+	// streams, so /stream flushes each chunk to the host instead of
+	// buffering the response.
+	mikhttp.HandleStreaming(mux)
+}
+
+// hello is the fixture's original single-endpoint behavior, now just one
+// route among several.
+func hello(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte(`{"message":"Hello from Go!","lang":"go"}`))
+}
+
+// proxyUpstream performs an outbound request through
+// http.DefaultClient (backed by mikhttp.NewRoundTripper) and relays the
+// aggregated result back to the inbound caller.
+func proxyUpstream(w http.ResponseWriter) {
+	resp, err := http.Get(upstream)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadGateway)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(map[string]any{
+		"message":        "proxied via Go outgoing-handler",
+		"upstreamStatus": resp.StatusCode,
+		"upstreamBody":   string(body),
 	})
 }
 
+// streamCounter demonstrates chunked, trailer-bearing streaming: it
+// flushes a handful of SSE-style events as they're produced rather than
+// buffering the whole response, then attaches a trailer once done if w
+// implements mikhttp.TrailerSetter. Served through mikhttp.HandleStreaming,
+// w is backed by an outgoing-body stream, so each Write below reaches the
+// host as its own chunk; that writer does not implement TrailerSetter, so
+// the trailer is only set under wadgetest, not sent over wasi:http.
+func streamCounter(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.WriteHeader(http.StatusOK)
+
+	flusher, _ := w.(http.Flusher)
+	for i := 1; i <= 3; i++ {
+		fmt.Fprintf(w, "data: %d\n\n", i)
+		if flusher != nil {
+			flusher.Flush()
+		}
+	}
+
+	if tw, ok := w.(mikhttp.TrailerSetter); ok {
+		tw.SetTrailer("X-Stream-Complete", "true")
+	}
+}
+
 func main() {}
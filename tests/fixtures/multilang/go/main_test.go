@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/json"
+	"io"
+	"testing"
+
+	"github.com/dufeut/mik/pkg/wadgetest"
+)
+
+func TestHandlerHello(t *testing.T) {
+	resp, err := wadgetest.Do(mux.ServeHTTP, wadgetest.NewRequest("GET", "/", nil))
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if resp.StatusCode != 200 {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	var body struct {
+		Message string `json:"message"`
+		Lang    string `json:"lang"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		t.Fatalf("decoding body: %v", err)
+	}
+	if body.Lang != "go" {
+		t.Errorf("lang = %q, want %q", body.Lang, "go")
+	}
+}
+
+func TestHandlerStream(t *testing.T) {
+	resp, err := wadgetest.Do(mux.ServeHTTP, wadgetest.NewRequest("GET", "/stream", nil))
+	if err != nil {
+		t.Fatalf("Do: %v", err)
+	}
+	if resp.StatusCode != 200 {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Errorf("Content-Type = %q, want text/event-stream", ct)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("reading body: %v", err)
+	}
+	const want = "data: 1\n\ndata: 2\n\ndata: 3\n\n"
+	if string(body) != want {
+		t.Errorf("body = %q, want %q", body, want)
+	}
+
+	if got := resp.Trailer.Get("X-Stream-Complete"); got != "true" {
+		t.Errorf("trailer X-Stream-Complete = %q, want %q", got, "true")
+	}
+}